@@ -0,0 +1,161 @@
+package vector
+
+import "slices"
+
+// SortFunc sorts v in place using cmp, which must report a negative number
+// when a should sort before b, a positive number when a should sort after
+// b, and zero when they are equal, as with slices.SortFunc.
+func (v *Vector[T]) SortFunc(cmp func(a, b T) int) {
+	v.withLock(func() {
+		slices.SortFunc(v.items, cmp)
+	})
+}
+
+// SortStableFunc is SortFunc but keeps the relative order of equal items.
+func (v *Vector[T]) SortStableFunc(cmp func(a, b T) int) {
+	v.withLock(func() {
+		slices.SortStableFunc(v.items, cmp)
+	})
+}
+
+// BinarySearchFunc searches v, which must be sorted in ascending order with
+// respect to cmp, for target. It returns the position where target is found,
+// or where it would be inserted, and whether it was found.
+func (v *Vector[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (index int, found bool) {
+	v.withRLock(func() {
+		index, found = slices.BinarySearchFunc(v.items, target, cmp)
+	})
+	return
+}
+
+// Reverse reverses v in place.
+func (v *Vector[T]) Reverse() {
+	v.withLock(func() {
+		slices.Reverse(v.items)
+	})
+}
+
+// CompactFunc collapses consecutive runs of items considered equal by eq
+// into a single item, in place. It reuses the existing backing array and
+// only shrinks the logical length, matching slices.CompactFunc.
+func (v *Vector[T]) CompactFunc(eq func(a, b T) bool) {
+	v.withLock(func() {
+		v.items = slices.CompactFunc(v.items, eq)
+	})
+}
+
+// InsertAll is the batch form of Add: it inserts items at index, shifting
+// the remaining elements once instead of once per item.
+func (v *Vector[T]) InsertAll(index int, items ...T) (err error) {
+	v.withLock(func() {
+		if index == 0 && v.len() == 0 {
+			v.append(items...)
+			return
+		}
+
+		err = v.checkIndex(index)
+		if err != nil {
+			return
+		}
+		if len(items) == 0 {
+			return
+		}
+
+		v.increaseCapToAtLest(v.len() + len(items))
+		v.items = slices.Insert(v.items, index, items...)
+	})
+	return
+}
+
+// Delete removes the items in the half-open range [i, j) in O(n-j), reusing
+// the existing backing array and only shrinking the logical length.
+func (v *Vector[T]) Delete(i, j int) (err error) {
+	v.withLock(func() {
+		if i < 0 || j < i || j > v.len() {
+			err = ErrInvalidIndex
+			return
+		}
+
+		v.items = slices.Delete(v.items, i, j)
+	})
+	return
+}
+
+// Replace replaces the items in the half-open range [i, j) with items,
+// growing or shrinking v as needed.
+func (v *Vector[T]) Replace(i, j int, items ...T) (err error) {
+	v.withLock(func() {
+		if i < 0 || j < i || j > v.len() {
+			err = ErrInvalidIndex
+			return
+		}
+
+		v.increaseCapToAtLest(v.len() - (j - i) + len(items))
+		v.items = slices.Replace(v.items, i, j, items...)
+	})
+	return
+}
+
+// Rotate rotates v left by k positions using the three-reverse trick; a
+// negative k rotates right.
+func (v *Vector[T]) Rotate(k int) {
+	v.withLock(func() {
+		n := v.len()
+		if n == 0 {
+			return
+		}
+
+		k = ((k % n) + n) % n
+		if k == 0 {
+			return
+		}
+
+		slices.Reverse(v.items[:k])
+		slices.Reverse(v.items[k:])
+		slices.Reverse(v.items)
+	})
+}
+
+// MinFunc returns the smallest item according to cmp, or ErrEmpty if v has
+// no items.
+func (v *Vector[T]) MinFunc(cmp func(a, b T) int) (r T, err error) {
+	v.withRLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = slices.MinFunc(v.items, cmp)
+	})
+	return
+}
+
+// MaxFunc returns the largest item according to cmp, or ErrEmpty if v has no
+// items.
+func (v *Vector[T]) MaxFunc(cmp func(a, b T) int) (r T, err error) {
+	v.withRLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = slices.MaxFunc(v.items, cmp)
+	})
+	return
+}
+
+// Grow ensures v has capacity for at least n more items without another
+// reallocation, mirroring slices.Grow.
+func (v *Vector[T]) Grow(n int) {
+	v.withLock(func() {
+		if n <= 0 {
+			return
+		}
+		v.increaseCapToAtLest(v.len() + n)
+	})
+}
+
+// Clip removes unused capacity from v, mirroring slices.Clip.
+func (v *Vector[T]) Clip() {
+	v.withLock(func() {
+		v.items = slices.Clip(v.items)
+	})
+}
@@ -0,0 +1,136 @@
+package vector_test
+
+import (
+	"cmp"
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"testing"
+)
+
+func TestVector_SortFunc(t *testing.T) {
+	v := vector.From(3, 1, 4, 1, 5)
+	v.SortFunc(cmp.Compare[int])
+	require.Equal(t, []int{1, 1, 3, 4, 5}, v.Slice())
+}
+
+func TestVector_SortStableFunc(t *testing.T) {
+	type item struct {
+		key   int
+		order int
+	}
+	v := vector.From(
+		item{key: 1, order: 0},
+		item{key: 2, order: 1},
+		item{key: 1, order: 2},
+	)
+	v.SortStableFunc(func(a, b item) int { return cmp.Compare(a.key, b.key) })
+	require.Equal(t, []int{0, 2, 1}, vector.Map(v, func(i item) int { return i.order }).Slice())
+}
+
+func TestVector_BinarySearchFunc(t *testing.T) {
+	v := vector.From(1, 3, 5, 7, 9)
+
+	index, found := v.BinarySearchFunc(5, cmp.Compare[int])
+	require.True(t, found)
+	require.Equal(t, 2, index)
+
+	index, found = v.BinarySearchFunc(4, cmp.Compare[int])
+	require.False(t, found)
+	require.Equal(t, 2, index)
+}
+
+func TestVector_Reverse(t *testing.T) {
+	v := vector.From(1, 2, 3, 4)
+	v.Reverse()
+	require.Equal(t, []int{4, 3, 2, 1}, v.Slice())
+}
+
+func TestVector_CompactFunc(t *testing.T) {
+	v := vector.From(1, 1, 2, 2, 2, 3, 1)
+	v.CompactFunc(func(a, b int) bool { return a == b })
+	require.Equal(t, []int{1, 2, 3, 1}, v.Slice())
+}
+
+func TestVector_InsertAll(t *testing.T) {
+	v := vector.From(1, 5)
+
+	require.NoError(t, v.InsertAll(1, 2, 3, 4))
+	require.Equal(t, []int{1, 2, 3, 4, 5}, v.Slice())
+
+	require.ErrorIs(t, v.InsertAll(99, 0), vector.ErrInvalidIndex)
+
+	v2 := vector.New[int]()
+	require.NoError(t, v2.InsertAll(0, 1, 2))
+	require.Equal(t, []int{1, 2}, v2.Slice())
+}
+
+func TestVector_Delete(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	require.NoError(t, v.Delete(1, 3))
+	require.Equal(t, []int{1, 4, 5}, v.Slice())
+
+	require.ErrorIs(t, v.Delete(-1, 1), vector.ErrInvalidIndex)
+	require.ErrorIs(t, v.Delete(2, 1), vector.ErrInvalidIndex)
+	require.ErrorIs(t, v.Delete(0, 99), vector.ErrInvalidIndex)
+}
+
+func TestVector_Replace(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	require.NoError(t, v.Replace(1, 3, 9, 9, 9))
+	require.Equal(t, []int{1, 9, 9, 9, 4, 5}, v.Slice())
+
+	require.ErrorIs(t, v.Replace(-1, 1, 0), vector.ErrInvalidIndex)
+}
+
+func TestVector_Rotate(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	v.Rotate(2)
+	require.Equal(t, []int{3, 4, 5, 1, 2}, v.Slice())
+
+	v.Rotate(-2)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, v.Slice())
+
+	empty := vector.New[int]()
+	empty.Rotate(3)
+	require.Equal(t, []int(nil), empty.Slice())
+}
+
+func TestVector_MinMaxFunc(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+
+		_, err := v.MinFunc(cmp.Compare[int])
+		require.ErrorIs(t, err, vector.ErrEmpty)
+
+		_, err = v.MaxFunc(cmp.Compare[int])
+		require.ErrorIs(t, err, vector.ErrEmpty)
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v := vector.From(3, 1, 4, 1, 5)
+
+		min, err := v.MinFunc(cmp.Compare[int])
+		require.NoError(t, err)
+		require.Equal(t, 1, min)
+
+		max, err := v.MaxFunc(cmp.Compare[int])
+		require.NoError(t, err)
+		require.Equal(t, 5, max)
+	})
+}
+
+func TestVector_Grow(t *testing.T) {
+	v := vector.NewWithCap[int](2, 2)
+	v.Grow(10)
+	require.GreaterOrEqual(t, v.Cap(), 10)
+}
+
+func TestVector_Clip(t *testing.T) {
+	v := vector.NewWithCap[int](10, 2)
+	v.AppendAll(1, 2, 3)
+	v.Clip()
+	require.Equal(t, 3, v.Cap())
+}
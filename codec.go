@@ -0,0 +1,161 @@
+package vector
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// WithCodec supplies an explicit element codec for the binary and gob
+// serialization forms, for element types T that aren't one of the built-in
+// primitives (bool, int, uint, the sized ints/uints, float32/64, string).
+func WithCodec[T any](enc func(T) ([]byte, error), dec func([]byte) (T, error)) Option[T] {
+	return func(v *Vector[T]) {
+		v.encode = enc
+		v.decode = dec
+	}
+}
+
+func (v *Vector[T]) elementEncoder() func(T) ([]byte, error) {
+	if v.encode != nil {
+		return v.encode
+	}
+	return defaultEncode[T]
+}
+
+func (v *Vector[T]) elementDecoder() func([]byte) (T, error) {
+	if v.decode != nil {
+		return v.decode
+	}
+	return defaultDecode[T]
+}
+
+func defaultEncode[T any](item T) ([]byte, error) {
+	switch val := any(item).(type) {
+	case bool:
+		if val {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case int:
+		return encodeUint64(uint64(val)), nil
+	case int8:
+		return []byte{byte(val)}, nil
+	case int16:
+		return encodeUint16(uint16(val)), nil
+	case int32:
+		return encodeUint32(uint32(val)), nil
+	case int64:
+		return encodeUint64(uint64(val)), nil
+	case uint:
+		return encodeUint64(uint64(val)), nil
+	case uint8:
+		return []byte{val}, nil
+	case uint16:
+		return encodeUint16(val), nil
+	case uint32:
+		return encodeUint32(val), nil
+	case uint64:
+		return encodeUint64(val), nil
+	case float32:
+		return encodeUint32(math.Float32bits(val)), nil
+	case float64:
+		return encodeUint64(math.Float64bits(val)), nil
+	case string:
+		return []byte(val), nil
+	default:
+		return nil, fmt.Errorf("vector: no default codec for %T, use WithCodec", item)
+	}
+}
+
+func defaultDecode[T any](data []byte) (r T, err error) {
+	switch any(r).(type) {
+	case bool:
+		if len(data) != 1 {
+			return r, ErrCorruptData
+		}
+		return any(data[0] != 0).(T), nil
+	case int:
+		if len(data) != 8 {
+			return r, ErrCorruptData
+		}
+		return any(int(binary.LittleEndian.Uint64(data))).(T), nil
+	case int8:
+		if len(data) != 1 {
+			return r, ErrCorruptData
+		}
+		return any(int8(data[0])).(T), nil
+	case int16:
+		if len(data) != 2 {
+			return r, ErrCorruptData
+		}
+		return any(int16(binary.LittleEndian.Uint16(data))).(T), nil
+	case int32:
+		if len(data) != 4 {
+			return r, ErrCorruptData
+		}
+		return any(int32(binary.LittleEndian.Uint32(data))).(T), nil
+	case int64:
+		if len(data) != 8 {
+			return r, ErrCorruptData
+		}
+		return any(int64(binary.LittleEndian.Uint64(data))).(T), nil
+	case uint:
+		if len(data) != 8 {
+			return r, ErrCorruptData
+		}
+		return any(uint(binary.LittleEndian.Uint64(data))).(T), nil
+	case uint8:
+		if len(data) != 1 {
+			return r, ErrCorruptData
+		}
+		return any(data[0]).(T), nil
+	case uint16:
+		if len(data) != 2 {
+			return r, ErrCorruptData
+		}
+		return any(binary.LittleEndian.Uint16(data)).(T), nil
+	case uint32:
+		if len(data) != 4 {
+			return r, ErrCorruptData
+		}
+		return any(binary.LittleEndian.Uint32(data)).(T), nil
+	case uint64:
+		if len(data) != 8 {
+			return r, ErrCorruptData
+		}
+		return any(binary.LittleEndian.Uint64(data)).(T), nil
+	case float32:
+		if len(data) != 4 {
+			return r, ErrCorruptData
+		}
+		return any(math.Float32frombits(binary.LittleEndian.Uint32(data))).(T), nil
+	case float64:
+		if len(data) != 8 {
+			return r, ErrCorruptData
+		}
+		return any(math.Float64frombits(binary.LittleEndian.Uint64(data))).(T), nil
+	case string:
+		return any(string(data)).(T), nil
+	default:
+		return r, fmt.Errorf("vector: no default codec for %T, use WithCodec", r)
+	}
+}
+
+func encodeUint16(val uint16) []byte {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, val)
+	return b
+}
+
+func encodeUint32(val uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, val)
+	return b
+}
+
+func encodeUint64(val uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, val)
+	return b
+}
@@ -0,0 +1,112 @@
+package vector
+
+// Push appends item to the back of v. It is an alias for Append, named for
+// callers using v as a stack.
+func (v *Vector[T]) Push(item T) {
+	v.Append(item)
+}
+
+// Pop removes and returns the last item of v, or ErrEmpty if v has none.
+func (v *Vector[T]) Pop() (r T, err error) {
+	v.withLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = v.remove(v.len() - 1)
+	})
+	return
+}
+
+// PopFront removes and returns the first item of v, or ErrEmpty if v has
+// none.
+func (v *Vector[T]) PopFront() (r T, err error) {
+	v.withLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = v.remove(0)
+	})
+	return
+}
+
+// PeekFront returns the first item of v without removing it, or ErrEmpty if
+// v has none.
+func (v *Vector[T]) PeekFront() (r T, err error) {
+	v.withRLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = v.items[0]
+	})
+	return
+}
+
+// PeekBack returns the last item of v without removing it, or ErrEmpty if v
+// has none.
+func (v *Vector[T]) PeekBack() (r T, err error) {
+	v.withRLock(func() {
+		if v.len() == 0 {
+			err = ErrEmpty
+			return
+		}
+		r = v.items[v.len()-1]
+	})
+	return
+}
+
+// RemoveUnordered removes the item at index in O(1) by swapping it with the
+// last item and truncating, which does not preserve ordering.
+func (v *Vector[T]) RemoveUnordered(index int) (r T, err error) {
+	v.withLock(func() {
+		err = v.checkIndex(index)
+		if err != nil {
+			return
+		}
+
+		last := v.len() - 1
+		r = v.items[index]
+		v.items[index] = v.items[last]
+
+		var zero T
+		v.items[last] = zero
+		v.items = v.items[:last]
+	})
+	return
+}
+
+// Truncate drops every item at index n and beyond, leaving v with at most n
+// items, without reallocating. A negative n empties v. Dropped
+// slots are zeroed so they are not retained when T holds pointers.
+func (v *Vector[T]) Truncate(n int) {
+	v.withLock(func() {
+		if n < 0 {
+			n = 0
+		}
+		if n >= v.len() {
+			return
+		}
+
+		var zero T
+		for i := n; i < v.len(); i++ {
+			v.items[i] = zero
+		}
+		v.items = v.items[:n]
+	})
+}
+
+// ShrinkToFit reallocates v's backing array down to exactly Len(), reclaiming
+// any spare capacity left over from large Remove sweeps.
+func (v *Vector[T]) ShrinkToFit() {
+	v.withLock(func() {
+		if v.len() == cap(v.items) {
+			return
+		}
+
+		items := make([]T, v.len())
+		copy(items, v.items)
+		v.items = items
+	})
+}
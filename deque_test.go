@@ -0,0 +1,105 @@
+package vector_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"testing"
+)
+
+func TestVector_Push(t *testing.T) {
+	v := vector.New[int]()
+	v.Push(1)
+	v.Push(2)
+	require.Equal(t, []int{1, 2}, v.Slice())
+}
+
+func TestVector_Pop(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+		_, err := v.Pop()
+		require.ErrorIs(t, err, vector.ErrEmpty)
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v := vector.From(1, 2, 3)
+
+		item, err := v.Pop()
+		require.NoError(t, err)
+		require.Equal(t, 3, item)
+		require.Equal(t, []int{1, 2}, v.Slice())
+	})
+}
+
+func TestVector_PopFront(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+		_, err := v.PopFront()
+		require.ErrorIs(t, err, vector.ErrEmpty)
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v := vector.From(1, 2, 3)
+
+		item, err := v.PopFront()
+		require.NoError(t, err)
+		require.Equal(t, 1, item)
+		require.Equal(t, []int{2, 3}, v.Slice())
+	})
+}
+
+func TestVector_PeekFrontBack(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+
+		_, err := v.PeekFront()
+		require.ErrorIs(t, err, vector.ErrEmpty)
+
+		_, err = v.PeekBack()
+		require.ErrorIs(t, err, vector.ErrEmpty)
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v := vector.From(1, 2, 3)
+
+		front, err := v.PeekFront()
+		require.NoError(t, err)
+		require.Equal(t, 1, front)
+
+		back, err := v.PeekBack()
+		require.NoError(t, err)
+		require.Equal(t, 3, back)
+	})
+}
+
+func TestVector_RemoveUnordered(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	item, err := v.RemoveUnordered(1)
+	require.NoError(t, err)
+	require.Equal(t, 2, item)
+	require.Equal(t, []int{1, 5, 3, 4}, v.Slice())
+
+	_, err = v.RemoveUnordered(99)
+	require.ErrorIs(t, err, vector.ErrInvalidIndex)
+}
+
+func TestVector_Truncate(t *testing.T) {
+	v := vector.NewWithCap[int](10, 2)
+	v.AppendAll(1, 2, 3, 4, 5)
+
+	v.Truncate(3)
+	require.Equal(t, []int{1, 2, 3}, v.Slice())
+	require.Equal(t, 10, v.Cap())
+
+	v.Truncate(-1)
+	require.Equal(t, []int(nil), v.Slice())
+}
+
+func TestVector_ShrinkToFit(t *testing.T) {
+	v := vector.NewWithCap[int](10, 2)
+	v.AppendAll(1, 2, 3)
+
+	v.ShrinkToFit()
+	require.Equal(t, 3, v.Cap())
+	require.Equal(t, []int{1, 2, 3}, v.Slice())
+}
@@ -0,0 +1,29 @@
+// Package num holds vector operations that only make sense for numeric
+// element types.
+package num
+
+import (
+	"github.com/ykhrustalev/go-vector"
+)
+
+// Number is the set of element types InnerProduct (and future numeric
+// operations) can operate on.
+type Number interface {
+	~int | ~int64 | ~float32 | ~float64
+}
+
+// InnerProduct returns the dot product of a and b. It returns ErrSizeDiffers
+// if the vectors don't have the same length.
+func InnerProduct[T Number](a, b *vector.Vector[T]) (r T, err error) {
+	as := a.Slice()
+	bs := b.Slice()
+	if len(as) != len(bs) {
+		err = vector.ErrSizeDiffers
+		return
+	}
+
+	for i, val1 := range as {
+		r += val1 * bs[i]
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package num_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"github.com/ykhrustalev/go-vector/num"
+	"testing"
+)
+
+func TestInnerProduct(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v1 := vector.New[int]()
+		v2 := vector.New[int]()
+
+		actual, err := num.InnerProduct(v1, v2)
+		require.NoError(t, err)
+		require.Equal(t, 0, actual)
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v1 := vector.From(1, 2, 3)
+		v2 := vector.From(2, 3, 4)
+
+		actual, err := num.InnerProduct(v1, v2)
+		require.NoError(t, err)
+		require.Equal(t, 1*2+2*3+3*4, actual)
+	})
+
+	t.Run("different size", func(t *testing.T) {
+		v1 := vector.New[int]()
+		v2 := vector.From(2, 3, 4)
+
+		_, err := num.InnerProduct(v1, v2)
+		require.Error(t, err)
+		require.Equal(t, vector.ErrSizeDiffers, err)
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		v1 := vector.From(1.5, 2.0)
+		v2 := vector.From(2.0, 3.0)
+
+		actual, err := num.InnerProduct(v1, v2)
+		require.NoError(t, err)
+		require.Equal(t, 1.5*2.0+2.0*3.0, actual)
+	})
+}
@@ -0,0 +1,203 @@
+// Package parallel provides CPU-bound variants of the vector package's
+// transform functions that fan work across multiple goroutines.
+//
+// Every function snapshots the source vector once (taking its read lock for
+// the duration of the copy) and then partitions the snapshot into
+// contiguous, non-overlapping index ranges, one per worker, so results come
+// back in the same order the serial equivalent would produce. Work is never
+// stolen between ranges.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+
+	vector "github.com/ykhrustalev/go-vector"
+)
+
+// ranges splits [0, total) into up to workers contiguous, non-overlapping
+// index ranges.
+func ranges(total, workers int) [][2]int {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > total {
+		workers = total
+	}
+	if workers == 0 {
+		return nil
+	}
+
+	base := total / workers
+	rem := total % workers
+
+	rs := make([][2]int, 0, workers)
+	start := 0
+	for i := 0; i < workers; i++ {
+		size := base
+		if i < rem {
+			size++
+		}
+		end := start + size
+		rs = append(rs, [2]int{start, end})
+		start = end
+	}
+	return rs
+}
+
+// run executes fn(workerIndex, start, end) for every range in rs on its own
+// goroutine, waits for all of them to finish, then re-panics with the first
+// panic recovered from any worker, if any.
+func run(rs [][2]int, fn func(workerIndex, start, end int)) {
+	if len(rs) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	panics := make(chan any, len(rs))
+
+	for i, r := range rs {
+		wg.Add(1)
+		go func(i int, r [2]int) {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					select {
+					case panics <- p:
+					default:
+					}
+				}
+			}()
+			fn(i, r[0], r[1])
+		}(i, r)
+	}
+	wg.Wait()
+
+	select {
+	case p := <-panics:
+		panic(p)
+	default:
+	}
+}
+
+// Map is MapN using runtime.NumCPU() workers.
+func Map[T, U any](v *vector.Vector[T], f func(item T) U) *vector.Vector[U] {
+	return MapN(v, runtime.NumCPU(), f)
+}
+
+// MapN applies f to every item of v across workers goroutines and returns
+// the results in a new vector, in the same order as v.
+func MapN[T, U any](v *vector.Vector[T], workers int, f func(item T) U) *vector.Vector[U] {
+	items := v.Slice()
+	out := make([]U, len(items))
+
+	run(ranges(len(items), workers), func(_, start, end int) {
+		for i := start; i < end; i++ {
+			out[i] = f(items[i])
+		}
+	})
+
+	return vector.From(out...)
+}
+
+// Filter is FilterN using runtime.NumCPU() workers.
+func Filter[T any](v *vector.Vector[T], f func(item T) bool) *vector.Vector[T] {
+	return FilterN(v, runtime.NumCPU(), f)
+}
+
+// FilterN returns a new vector holding the items of v for which f returns
+// true, in the same relative order as v.
+func FilterN[T any](v *vector.Vector[T], workers int, f func(item T) bool) *vector.Vector[T] {
+	items := v.Slice()
+	rs := ranges(len(items), workers)
+	partials := make([][]T, len(rs))
+
+	run(rs, func(i, start, end int) {
+		var local []T
+		for j := start; j < end; j++ {
+			if f(items[j]) {
+				local = append(local, items[j])
+			}
+		}
+		partials[i] = local
+	})
+
+	r := vector.NewWithCap[T](len(items), 2)
+	for _, part := range partials {
+		r.AppendAll(part...)
+	}
+	return r
+}
+
+// Each is EachN using runtime.NumCPU() workers.
+func Each[T any](v *vector.Vector[T], f func(item T)) {
+	EachN(v, runtime.NumCPU(), f)
+}
+
+// EachN calls f once per item of v, fanned out across workers goroutines.
+// Unlike the serial (*Vector[T]).Each, it cannot be stopped early and gives
+// no ordering guarantee between calls to f.
+func EachN[T any](v *vector.Vector[T], workers int, f func(item T)) {
+	items := v.Slice()
+	run(ranges(len(items), workers), func(_, start, end int) {
+		for i := start; i < end; i++ {
+			f(items[i])
+		}
+	})
+}
+
+// Reduce is ReduceN using runtime.NumCPU() workers.
+func Reduce[T any](v *vector.Vector[T], combine func(a, b T) T) T {
+	return ReduceN(v, runtime.NumCPU(), combine)
+}
+
+// ReduceN combines the items of v using combine, which MUST be associative:
+// each worker reduces its own contiguous range serially, then the per-worker
+// partial results are combined pairwise in a tree, not left-to-right, so a
+// non-associative combine will produce a result that depends on the worker
+// count. It returns the zero value of T if v is empty.
+func ReduceN[T any](v *vector.Vector[T], workers int, combine func(a, b T) T) (r T) {
+	items := v.Slice()
+	if len(items) == 0 {
+		return
+	}
+
+	rs := ranges(len(items), workers)
+	partials := make([]T, len(rs))
+
+	run(rs, func(i, start, end int) {
+		acc := items[start]
+		for j := start + 1; j < end; j++ {
+			acc = combine(acc, items[j])
+		}
+		partials[i] = acc
+	})
+
+	for len(partials) > 1 {
+		next := make([]T, 0, (len(partials)+1)/2)
+		for i := 0; i < len(partials); i += 2 {
+			if i+1 < len(partials) {
+				next = append(next, combine(partials[i], partials[i+1]))
+			} else {
+				next = append(next, partials[i])
+			}
+		}
+		partials = next
+	}
+	return partials[0]
+}
+
+// ForEachBatch is ForEachBatchN using runtime.NumCPU() workers.
+func ForEachBatch[T any](v *vector.Vector[T], f func(batch []T)) {
+	ForEachBatchN(v, runtime.NumCPU(), f)
+}
+
+// ForEachBatchN partitions v into workers contiguous batches and calls f
+// once per batch, each on its own goroutine. Useful when per-item overhead
+// should be amortized (e.g. batched I/O) rather than calling f per element.
+func ForEachBatchN[T any](v *vector.Vector[T], workers int, f func(batch []T)) {
+	items := v.Slice()
+	run(ranges(len(items), workers), func(_, start, end int) {
+		f(items[start:end])
+	})
+}
@@ -0,0 +1,43 @@
+package parallel_test
+
+import (
+	"testing"
+
+	vector "github.com/ykhrustalev/go-vector"
+	"github.com/ykhrustalev/go-vector/parallel"
+)
+
+func makeBenchVector(n int) *vector.Vector[int] {
+	v := vector.NewWithCap[int](n, 2)
+	for i := 0; i < n; i++ {
+		v.Append(i)
+	}
+	return v
+}
+
+func square(item int) int { return item * item }
+
+func benchmarkMapSerial(b *testing.B, n int) {
+	v := makeBenchVector(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vector.Map(v, square)
+	}
+}
+
+func benchmarkMapParallel(b *testing.B, n int) {
+	v := makeBenchVector(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parallel.Map(v, square)
+	}
+}
+
+func BenchmarkMap_Serial_1K(b *testing.B)   { benchmarkMapSerial(b, 1_000) }
+func BenchmarkMap_Parallel_1K(b *testing.B) { benchmarkMapParallel(b, 1_000) }
+func BenchmarkMap_Serial_100K(b *testing.B) { benchmarkMapSerial(b, 100_000) }
+func BenchmarkMap_Parallel_100K(b *testing.B) {
+	benchmarkMapParallel(b, 100_000)
+}
+func BenchmarkMap_Serial_1M(b *testing.B)   { benchmarkMapSerial(b, 1_000_000) }
+func BenchmarkMap_Parallel_1M(b *testing.B) { benchmarkMapParallel(b, 1_000_000) }
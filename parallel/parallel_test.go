@@ -0,0 +1,85 @@
+package parallel_test
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	vector "github.com/ykhrustalev/go-vector"
+	"github.com/ykhrustalev/go-vector/parallel"
+)
+
+func TestMapN(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+	r := parallel.MapN(v, 3, func(item int) int { return item * item })
+	require.Equal(t, []int{1, 4, 9, 16, 25}, r.Slice())
+}
+
+func TestMap_empty(t *testing.T) {
+	v := vector.New[int]()
+	r := parallel.Map(v, func(item int) int { return item })
+	require.Equal(t, []int(nil), r.Slice())
+}
+
+func TestFilterN(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	r := parallel.FilterN(v, 4, func(item int) bool { return item%2 == 0 })
+	require.Equal(t, []int{2, 4, 6, 8, 10}, r.Slice())
+}
+
+func TestEachN(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	var sum int64
+	parallel.EachN(v, 3, func(item int) {
+		atomic.AddInt64(&sum, int64(item))
+	})
+	require.Equal(t, int64(15), sum)
+}
+
+func TestReduceN(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5, 6, 7)
+	sum := parallel.ReduceN(v, 3, func(a, b int) int { return a + b })
+	require.Equal(t, 28, sum)
+}
+
+func TestReduce_empty(t *testing.T) {
+	v := vector.New[int]()
+	r := parallel.Reduce(v, func(a, b int) int { return a + b })
+	require.Equal(t, 0, r)
+}
+
+func TestForEachBatchN(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5, 6, 7)
+
+	var mu sync.Mutex
+	var batches [][]int
+	parallel.ForEachBatchN(v, 3, func(batch []int) {
+		cp := append([]int(nil), batch...)
+		mu.Lock()
+		batches = append(batches, cp)
+		mu.Unlock()
+	})
+
+	var flattened []int
+	for _, b := range batches {
+		flattened = append(flattened, b...)
+	}
+	sort.Ints(flattened)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, flattened)
+}
+
+func TestMapN_panicPropagates(t *testing.T) {
+	v := vector.From(1, 2, 3)
+
+	require.Panics(t, func() {
+		parallel.MapN(v, 3, func(item int) int {
+			if item == 2 {
+				panic("boom")
+			}
+			return item
+		})
+	})
+}
@@ -0,0 +1,46 @@
+package vector
+
+// Resize grows or shrinks v to exactly newLen items and ensures its capacity
+// is at least newCap, matching the classic container/vector Resize shape.
+// New slots introduced by growing the length are zero-filled; slots dropped
+// by shrinking the length are zeroed too, so they aren't retained when T
+// holds pointers. It returns v for chaining.
+func (v *Vector[T]) Resize(newLen, newCap int) *Vector[T] {
+	if newLen < 0 {
+		newLen = 0
+	}
+
+	v.withLock(func() {
+		if newCap > cap(v.items) {
+			target := newCap
+			if newLen > target {
+				target = newLen
+			}
+			v.increaseCapTo(target)
+		}
+
+		var zero T
+		switch {
+		case newLen > v.len():
+			for v.len() < newLen {
+				v.items = append(v.items, zero)
+			}
+		case newLen < v.len():
+			for i := newLen; i < v.len(); i++ {
+				v.items[i] = zero
+			}
+			v.items = v.items[:newLen]
+		}
+	})
+	return v
+}
+
+// Fill overwrites every existing item of v with value, leaving its length
+// and capacity unchanged.
+func (v *Vector[T]) Fill(value T) {
+	v.withLock(func() {
+		for i := range v.items {
+			v.items[i] = value
+		}
+	})
+}
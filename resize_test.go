@@ -0,0 +1,48 @@
+package vector_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"testing"
+)
+
+func TestVector_Resize(t *testing.T) {
+	t.Run("grow length zero-fills", func(t *testing.T) {
+		v := vector.From(1, 2, 3)
+
+		r := v.Resize(5, 0)
+		require.Same(t, v, r)
+		require.Equal(t, []int{1, 2, 3, 0, 0}, v.Slice())
+	})
+
+	t.Run("shrink length", func(t *testing.T) {
+		v := vector.From(1, 2, 3, 4, 5)
+
+		v.Resize(2, 0)
+		require.Equal(t, []int{1, 2}, v.Slice())
+	})
+
+	t.Run("grow capacity without growing length", func(t *testing.T) {
+		v := vector.NewWithCap[int](2, 2)
+		v.AppendAll(1, 2)
+
+		v.Resize(2, 50)
+		require.Equal(t, []int{1, 2}, v.Slice())
+		require.GreaterOrEqual(t, v.Cap(), 50)
+	})
+
+	t.Run("shrink drops pointers", func(t *testing.T) {
+		a, b := 1, 2
+		v := vector.From(&a, &b)
+
+		v.Resize(1, 0)
+		require.Equal(t, []*int{&a}, v.Slice())
+	})
+}
+
+func TestVector_Fill(t *testing.T) {
+	v := vector.From(1, 2, 3)
+	v.Fill(9)
+	require.Equal(t, []int{9, 9, 9}, v.Slice())
+	require.Equal(t, 3, v.Len())
+}
@@ -0,0 +1,218 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+const (
+	binaryMagic   = "GVEC"
+	binaryVersion = 1
+
+	// binaryHeaderSize is len(binaryMagic) + 1 version byte + 4 count bytes.
+	binaryHeaderSize = len(binaryMagic) + 1 + 4
+
+	// maxPreallocCount and maxElementPayloadSize bound how much memory
+	// ReadFrom will allocate on the strength of an untrusted element count
+	// or per-item length prefix, before the bytes backing them have even
+	// been read off the wire.
+	maxPreallocCount      = 1 << 16        // 65536 elements
+	maxElementPayloadSize = 64 * (1 << 20) // 64 MiB
+)
+
+var (
+	ErrBadMagic           = errors.New("vector: invalid binary frame magic")
+	ErrUnsupportedVersion = errors.New("vector: unsupported binary frame version")
+	ErrCorruptData        = errors.New("vector: corrupt binary frame")
+)
+
+// MarshalJSON emits v as a JSON array of its items.
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	items := v.Slice()
+	if items == nil {
+		items = []T{}
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON replaces v's contents with the items of a JSON array.
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	v.withLock(func() {
+		if v.multiplier == 0 {
+			v.multiplier = defaultMultiplier
+		}
+		v.items = v.items[:0]
+		v.increaseCapToAtLest(len(items))
+		v.items = append(v.items, items...)
+	})
+	return nil
+}
+
+// MarshalBinary encodes v as a length-prefixed little-endian frame: a 4-byte
+// magic ("GVEC"), a 1-byte version, a 4-byte element count, then each
+// element as a 4-byte length followed by its codec-encoded payload.
+func (v *Vector[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := v.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary replaces v's contents with the frame produced by
+// MarshalBinary.
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	items, _, err := v.readFrame(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	v.withLock(func() {
+		if v.multiplier == 0 {
+			v.multiplier = defaultMultiplier
+		}
+		v.items = items
+	})
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by reusing the binary frame format.
+func (v *Vector[T]) GobEncode() ([]byte, error) {
+	return v.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder by reusing the binary frame format.
+func (v *Vector[T]) GobDecode(data []byte) error {
+	return v.UnmarshalBinary(data)
+}
+
+// WriteTo streams v's binary frame (see MarshalBinary) to w, so large
+// vectors can be checkpointed without materializing the whole frame in
+// memory. It takes the read lock for only as long as it takes to snapshot
+// v's items.
+func (v *Vector[T]) WriteTo(w io.Writer) (n int64, err error) {
+	var items []T
+	var encode func(T) ([]byte, error)
+	v.withRLock(func() {
+		items = make([]T, len(v.items))
+		copy(items, v.items)
+		encode = v.elementEncoder()
+	})
+
+	header := make([]byte, binaryHeaderSize)
+	copy(header, binaryMagic)
+	header[len(binaryMagic)] = binaryVersion
+	binary.LittleEndian.PutUint32(header[len(binaryMagic)+1:], uint32(len(items)))
+
+	wn, err := w.Write(header)
+	n += int64(wn)
+	if err != nil {
+		return n, err
+	}
+
+	lenBuf := make([]byte, 4)
+	for _, item := range items {
+		payload, encErr := encode(item)
+		if encErr != nil {
+			return n, encErr
+		}
+
+		binary.LittleEndian.PutUint32(lenBuf, uint32(len(payload)))
+		wn, err = w.Write(lenBuf)
+		n += int64(wn)
+		if err != nil {
+			return n, err
+		}
+
+		wn, err = w.Write(payload)
+		n += int64(wn)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom streams a binary frame (see MarshalBinary) from r and appends its
+// items to v, growing v's capacity once up front instead of reallocating per
+// item.
+func (v *Vector[T]) ReadFrom(r io.Reader) (n int64, err error) {
+	items, n, err := v.readFrame(r)
+	if err != nil {
+		return n, err
+	}
+
+	v.withLock(func() {
+		v.increaseCapToAtLest(v.len() + len(items))
+		v.items = append(v.items, items...)
+	})
+	return n, nil
+}
+
+// readFrame decodes a binary frame from r into a freshly allocated slice,
+// without touching v's items. Preallocation sizes are clamped so a corrupt
+// or malicious count/length field can't force an unbounded allocation
+// before the bytes backing it are actually read off r.
+func (v *Vector[T]) readFrame(r io.Reader) (items []T, n int64, err error) {
+	header := make([]byte, binaryHeaderSize)
+	rn, err := io.ReadFull(r, header)
+	n += int64(rn)
+	if err != nil {
+		return nil, n, err
+	}
+
+	if string(header[:len(binaryMagic)]) != binaryMagic {
+		return nil, n, ErrBadMagic
+	}
+	if header[len(binaryMagic)] != binaryVersion {
+		return nil, n, ErrUnsupportedVersion
+	}
+	count := int(binary.LittleEndian.Uint32(header[len(binaryMagic)+1:]))
+
+	var decode func([]byte) (T, error)
+	v.withRLock(func() {
+		decode = v.elementDecoder()
+	})
+
+	prealloc := count
+	if prealloc > maxPreallocCount {
+		prealloc = maxPreallocCount
+	}
+
+	items = make([]T, 0, prealloc)
+	lenBuf := make([]byte, 4)
+	for i := 0; i < count; i++ {
+		rn, err = io.ReadFull(r, lenBuf)
+		n += int64(rn)
+		if err != nil {
+			return nil, n, err
+		}
+
+		payloadLen := binary.LittleEndian.Uint32(lenBuf)
+		if payloadLen > maxElementPayloadSize {
+			return nil, n, ErrCorruptData
+		}
+
+		payload := make([]byte, payloadLen)
+		rn, err = io.ReadFull(r, payload)
+		n += int64(rn)
+		if err != nil {
+			return nil, n, err
+		}
+
+		item, decErr := decode(payload)
+		if decErr != nil {
+			return nil, n, decErr
+		}
+		items = append(items, item)
+	}
+	return items, n, nil
+}
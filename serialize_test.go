@@ -0,0 +1,135 @@
+package vector_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"testing"
+)
+
+func TestVector_JSON(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		require.JSONEq(t, "[]", string(data))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		v := vector.From(1, 2, 3)
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		require.JSONEq(t, "[1,2,3]", string(data))
+
+		var out vector.Vector[int]
+		require.NoError(t, json.Unmarshal(data, &out))
+		require.Equal(t, []int{1, 2, 3}, out.Slice())
+	})
+}
+
+func TestVector_Binary(t *testing.T) {
+	t.Run("round trip ints", func(t *testing.T) {
+		v := vector.From(1, 2, 3, -4)
+		data, err := v.MarshalBinary()
+		require.NoError(t, err)
+
+		var out vector.Vector[int]
+		require.NoError(t, out.UnmarshalBinary(data))
+		require.Equal(t, []int{1, 2, 3, -4}, out.Slice())
+	})
+
+	t.Run("round trip strings", func(t *testing.T) {
+		v := vector.From("alpha", "beta", "")
+		data, err := v.MarshalBinary()
+		require.NoError(t, err)
+
+		var out vector.Vector[string]
+		require.NoError(t, out.UnmarshalBinary(data))
+		require.Equal(t, []string{"alpha", "beta", ""}, out.Slice())
+	})
+
+	t.Run("round trip floats", func(t *testing.T) {
+		v := vector.From(1.5, -2.25)
+		data, err := v.MarshalBinary()
+		require.NoError(t, err)
+
+		var out vector.Vector[float64]
+		require.NoError(t, out.UnmarshalBinary(data))
+		require.Equal(t, []float64{1.5, -2.25}, out.Slice())
+	})
+
+	t.Run("bad magic", func(t *testing.T) {
+		var out vector.Vector[int]
+		require.ErrorIs(t, out.UnmarshalBinary([]byte("XXXX\x01\x00\x00\x00\x00")), vector.ErrBadMagic)
+	})
+
+	t.Run("unsupported type without codec", func(t *testing.T) {
+		type point struct{ x, y int }
+		v := vector.From(point{1, 2})
+		_, err := v.MarshalBinary()
+		require.Error(t, err)
+	})
+}
+
+func TestVector_WriteToReadFrom(t *testing.T) {
+	v := vector.From(10, 20, 30)
+
+	var buf bytes.Buffer
+	written, err := v.WriteTo(&buf)
+	require.NoError(t, err)
+	require.Equal(t, int64(buf.Len()), written)
+
+	out := vector.New[int]()
+	read, err := out.ReadFrom(&buf)
+	require.NoError(t, err)
+	require.Equal(t, written, read)
+	require.Equal(t, []int{10, 20, 30}, out.Slice())
+
+	// ReadFrom appends rather than replaces.
+	read2, err := out.ReadFrom(bytes.NewReader(mustBinary(t, vector.From(40))))
+	require.NoError(t, err)
+	require.Greater(t, read2, int64(0))
+	require.Equal(t, []int{10, 20, 30, 40}, out.Slice())
+}
+
+func mustBinary(t *testing.T, v *vector.Vector[int]) []byte {
+	data, err := v.MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+func TestVector_Gob(t *testing.T) {
+	v := vector.From(1, 2, 3)
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(v))
+
+	var out vector.Vector[int]
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	require.Equal(t, []int{1, 2, 3}, out.Slice())
+}
+
+type point struct {
+	X, Y int
+}
+
+func TestVector_WithCodec(t *testing.T) {
+	encode := func(p point) ([]byte, error) { return json.Marshal(p) }
+	decode := func(data []byte) (point, error) {
+		var p point
+		err := json.Unmarshal(data, &p)
+		return p, err
+	}
+
+	v := vector.NewWithCap[point](2, 2, vector.WithCodec(encode, decode))
+	v.AppendAll(point{X: 1, Y: 2}, point{X: 3, Y: 4})
+
+	data, err := v.MarshalBinary()
+	require.NoError(t, err)
+
+	out := vector.NewWithCap[point](2, 2, vector.WithCodec(encode, decode))
+	require.NoError(t, out.UnmarshalBinary(data))
+	require.Equal(t, []point{{X: 1, Y: 2}, {X: 3, Y: 4}}, out.Slice())
+}
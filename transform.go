@@ -0,0 +1,181 @@
+package vector
+
+import "unsafe"
+
+// Pair is the element type produced by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Map applies f to every item of v and collects the results into a new
+// vector. It does not share backing storage with v.
+func Map[T, U any](v *Vector[T], f func(item T) U) *Vector[U] {
+	r := NewWithCap[U](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		for _, item := range v.items {
+			r.append(f(item))
+		}
+	})
+	return r
+}
+
+// FlatMap applies f to every item of v and concatenates the resulting
+// slices into a new vector.
+func FlatMap[T, U any](v *Vector[T], f func(item T) []U) *Vector[U] {
+	r := NewWithCap[U](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		for _, item := range v.items {
+			r.append(f(item)...)
+		}
+	})
+	return r
+}
+
+// Filter returns a new vector holding the items of v for which f returns
+// true, preserving their relative order.
+func Filter[T any](v *Vector[T], f func(item T) bool) *Vector[T] {
+	r := NewWithCap[T](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		for _, item := range v.items {
+			if f(item) {
+				r.append(item)
+			}
+		}
+	})
+	return r
+}
+
+// Take returns a new vector holding at most the first n items of v.
+func Take[T any](v *Vector[T], n int) *Vector[T] {
+	r := NewWithCap[T](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		limit := clamp(n, 0, len(v.items))
+		r.append(v.items[:limit]...)
+	})
+	return r
+}
+
+// Drop returns a new vector holding every item of v after the first n.
+func Drop[T any](v *Vector[T], n int) *Vector[T] {
+	r := NewWithCap[T](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		start := clamp(n, 0, len(v.items))
+		r.append(v.items[start:]...)
+	})
+	return r
+}
+
+func clamp(n, min, max int) int {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+// Partition splits v into two vectors: one holding the items for which f
+// returns true, the other holding the rest. Relative order is preserved in
+// both.
+func Partition[T any](v *Vector[T], f func(item T) bool) (matched *Vector[T], rest *Vector[T]) {
+	matched = NewWithCap[T](v.Len(), defaultMultiplier)
+	rest = NewWithCap[T](v.Len(), defaultMultiplier)
+	v.withRLock(func() {
+		for _, item := range v.items {
+			if f(item) {
+				matched.append(item)
+			} else {
+				rest.append(item)
+			}
+		}
+	})
+	return
+}
+
+// GroupBy buckets the items of v by the key keyFn produces, preserving the
+// relative order of items within each bucket.
+func GroupBy[T any, K comparable](v *Vector[T], keyFn func(item T) K) map[K]*Vector[T] {
+	r := make(map[K]*Vector[T])
+	v.withRLock(func() {
+		for _, item := range v.items {
+			key := keyFn(item)
+			g, ok := r[key]
+			if !ok {
+				g = NewWithCap[T](v.len(), defaultMultiplier)
+				r[key] = g
+			}
+			g.append(item)
+		}
+	})
+	return r
+}
+
+// Zip pairs up items of a and b by index, stopping at the shorter vector.
+func Zip[A, B any](a *Vector[A], b *Vector[B]) *Vector[Pair[A, B]] {
+	var r *Vector[Pair[A, B]]
+	withBothRLock(a, b, func() {
+		n := clamp(len(a.items), 0, len(b.items))
+		r = NewWithCap[Pair[A, B]](n, defaultMultiplier)
+		for i := 0; i < n; i++ {
+			r.append(Pair[A, B]{First: a.items[i], Second: b.items[i]})
+		}
+	})
+	return r
+}
+
+// withBothRLock takes read locks on a and b in a canonical order determined
+// by their addresses, rather than argument order, so that two calls locking
+// the same pair of vectors in opposite argument order can't deadlock each
+// other.
+func withBothRLock[A, B any](a *Vector[A], b *Vector[B], cb func()) {
+	pa := unsafe.Pointer(a)
+	pb := unsafe.Pointer(b)
+	if pa == pb {
+		// a and b are the same vector (possible whenever A == B, e.g.
+		// Zip(v, v)): a second RLock from this goroutine is safe per se, but
+		// a concurrent Lock() queued in between the two RLock calls would
+		// stall it forever, so take the lock only once.
+		a.withRLock(cb)
+		return
+	}
+	if uintptr(pa) <= uintptr(pb) {
+		a.withRLock(func() {
+			b.withRLock(cb)
+		})
+		return
+	}
+	b.withRLock(func() {
+		a.withRLock(cb)
+	})
+}
+
+// Chunk splits v into consecutive sub-vectors of at most n items each. A
+// non-positive n yields no chunks.
+func Chunk[T any](v *Vector[T], n int) *Vector[*Vector[T]] {
+	if n <= 0 {
+		return New[*Vector[T]]()
+	}
+
+	length := v.Len()
+	chunks := length / n
+	if length%n != 0 {
+		chunks++
+	}
+
+	r := NewWithCap[*Vector[T]](chunks, defaultMultiplier)
+	v.withRLock(func() {
+		for i := 0; i < len(v.items); i += n {
+			end := i + n
+			if end > len(v.items) {
+				end = len(v.items)
+			}
+
+			c := NewWithCap[T](end-i, defaultMultiplier)
+			c.append(v.items[i:end]...)
+			r.append(c)
+		}
+	})
+	return r
+}
@@ -0,0 +1,136 @@
+package vector_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"github.com/ykhrustalev/go-vector"
+	"testing"
+	"time"
+)
+
+func TestMap(t *testing.T) {
+	v := vector.From(1, 2, 3)
+	r := vector.Map(v, func(item int) string {
+		return string(rune('a' + item))
+	})
+	requireVector(t, r, 3, []string{"b", "c", "d"})
+}
+
+func TestFlatMap(t *testing.T) {
+	v := vector.From(1, 2, 3)
+	r := vector.FlatMap(v, func(item int) []int {
+		return []int{item, item}
+	})
+	requireVector(t, r, 6, []int{1, 1, 2, 2, 3, 3})
+}
+
+func TestFilter(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+	r := vector.Filter(v, func(item int) bool { return item%2 == 0 })
+	requireVector(t, r, 5, []int{2, 4})
+}
+
+func TestTake(t *testing.T) {
+	v := vector.From(1, 2, 3)
+
+	require.Equal(t, []int{1, 2}, vector.Take(v, 2).Slice())
+	require.Equal(t, []int{1, 2, 3}, vector.Take(v, 99).Slice())
+	require.Equal(t, []int(nil), vector.Take(v, 0).Slice())
+}
+
+func TestDrop(t *testing.T) {
+	v := vector.From(1, 2, 3)
+
+	require.Equal(t, []int{2, 3}, vector.Drop(v, 1).Slice())
+	require.Equal(t, []int(nil), vector.Drop(v, 99).Slice())
+	require.Equal(t, []int{1, 2, 3}, vector.Drop(v, 0).Slice())
+}
+
+func TestPartition(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+	even, odd := vector.Partition(v, func(item int) bool { return item%2 == 0 })
+
+	require.Equal(t, []int{2, 4}, even.Slice())
+	require.Equal(t, []int{1, 3, 5}, odd.Slice())
+}
+
+func TestGroupBy(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5, 6)
+	groups := vector.GroupBy(v, func(item int) bool { return item%2 == 0 })
+
+	require.Equal(t, []int{2, 4, 6}, groups[true].Slice())
+	require.Equal(t, []int{1, 3, 5}, groups[false].Slice())
+}
+
+func TestZip(t *testing.T) {
+	a := vector.From(1, 2, 3)
+	b := vector.From("a", "b")
+
+	r := vector.Zip(a, b)
+	requireVector(t, r, 2, []vector.Pair[int, string]{
+		{First: 1, Second: "a"},
+		{First: 2, Second: "b"},
+	})
+}
+
+// TestZip_SamePointer guards against a same-vector deadlock: Zip(v, v)
+// must not try to take v's read lock twice, since a concurrent writer
+// queued in between the two RLock calls would stall the second one
+// forever.
+func TestZip_SamePointer(t *testing.T) {
+	v := vector.From(1, 2, 3)
+
+	stop := make(chan struct{})
+	done := make(chan struct{}, 2)
+
+	go func() {
+		for {
+			select {
+			case <-stop:
+				done <- struct{}{}
+				return
+			default:
+			}
+			vector.Zip(v, v)
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-stop:
+				done <- struct{}{}
+				return
+			default:
+			}
+			v.Append(1)
+			_, _ = v.Pop()
+		}
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	close(stop)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Zip(v, v) deadlocked under concurrent writes")
+		}
+	}
+}
+
+func TestChunk(t *testing.T) {
+	v := vector.From(1, 2, 3, 4, 5)
+
+	chunks := vector.Chunk(v, 2)
+	require.Equal(t, 3, chunks.Len())
+
+	var got [][]int
+	chunks.Each(func(index int, item *vector.Vector[int]) bool {
+		got = append(got, item.Slice())
+		return true
+	})
+	require.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, got)
+
+	require.Equal(t, 0, vector.Chunk(v, 0).Len())
+}
@@ -8,6 +8,7 @@ import (
 var (
 	ErrInvalidIndex = errors.New("missing element with the given index")
 	ErrSizeDiffers  = errors.New("non equal vectors")
+	ErrEmpty        = errors.New("vector is empty")
 )
 
 const (
@@ -15,43 +16,54 @@ const (
 	defaultMultiplier = 2
 )
 
-type Vector struct {
+// Vector is a generic, growable, thread-safe sequence of T.
+type Vector[T any] struct {
 	multiplier int
-	items      []int
+	items      []T
+
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
 
 	mx sync.RWMutex
 }
 
-func From(items ...int) *Vector {
-	v := New()
+// Option configures a Vector at construction time, e.g. WithCodec.
+type Option[T any] func(*Vector[T])
+
+func From[T any](items ...T) *Vector[T] {
+	v := New[T]()
 	v.AppendAll(items...)
 	return v
 }
 
-func New() *Vector {
-	return NewWithCap(defaultCapacity, defaultMultiplier)
+func New[T any]() *Vector[T] {
+	return NewWithCap[T](defaultCapacity, defaultMultiplier)
 }
 
-func NewWithCap(cap int, multiplier int) *Vector {
-	return &Vector{
+func NewWithCap[T any](cap int, multiplier int, opts ...Option[T]) *Vector[T] {
+	v := &Vector[T]{
 		multiplier: multiplier,
-		items:      make([]int, 0, cap),
+		items:      make([]T, 0, cap),
+	}
+	for _, opt := range opts {
+		opt(v)
 	}
+	return v
 }
 
-func (v *Vector) withLock(cb func()) {
+func (v *Vector[T]) withLock(cb func()) {
 	v.mx.Lock()
 	defer v.mx.Unlock()
 	cb()
 }
 
-func (v *Vector) withRLock(cb func()) {
+func (v *Vector[T]) withRLock(cb func()) {
 	v.mx.RLock()
 	defer v.mx.RUnlock()
 	cb()
 }
 
-func (v *Vector) increaseCapToAtLest(targetCap int) {
+func (v *Vector[T]) increaseCapToAtLest(targetCap int) {
 	if targetCap <= cap(v.items) {
 		return
 	}
@@ -65,22 +77,22 @@ func (v *Vector) increaseCapToAtLest(targetCap int) {
 	v.increaseCapTo(newCap)
 }
 
-func (v *Vector) increaseCapTo(newCap int) {
+func (v *Vector[T]) increaseCapTo(newCap int) {
 	if newCap <= cap(v.items) {
 		return
 	}
-	items := make([]int, 0, newCap)
+	items := make([]T, 0, newCap)
 	v.items = append(items, v.items...)
 }
 
-func (v *Vector) checkIndex(index int) error {
+func (v *Vector[T]) checkIndex(index int) error {
 	if index < 0 || index > v.len()-1 {
 		return ErrInvalidIndex
 	}
 	return nil
 }
 
-func (v *Vector) Add(index int, item int) (err error) {
+func (v *Vector[T]) Add(index int, item T) (err error) {
 	v.withLock(func() {
 		if index == 0 && v.len() == 0 {
 			// append like operation
@@ -95,7 +107,8 @@ func (v *Vector) Add(index int, item int) (err error) {
 
 		v.increaseCapToAtLest(v.len() + 1)
 
-		v.items = append(v.items, 0) // increase
+		var zero T
+		v.items = append(v.items, zero) // increase
 		copy(v.items[index+1:], v.items[index:])
 		v.items[index] = item
 	})
@@ -103,7 +116,7 @@ func (v *Vector) Add(index int, item int) (err error) {
 	return
 }
 
-func (v *Vector) Set(index int, item int) (err error) {
+func (v *Vector[T]) Set(index int, item T) (err error) {
 	v.withLock(func() {
 		err = v.checkIndex(index)
 		if err != nil {
@@ -116,30 +129,30 @@ func (v *Vector) Set(index int, item int) (err error) {
 	return
 }
 
-func (v *Vector) append(items ...int) {
+func (v *Vector[T]) append(items ...T) {
 	v.increaseCapToAtLest(v.len() + len(items))
 	v.items = append(v.items, items...)
 }
 
-func (v *Vector) Append(item int) {
+func (v *Vector[T]) Append(item T) {
 	v.withLock(func() {
 		v.append(item)
 	})
 }
 
-func (v *Vector) AppendAll(items ...int) {
+func (v *Vector[T]) AppendAll(items ...T) {
 	v.withLock(func() {
 		v.append(items...)
 	})
 }
 
-func (v *Vector) remove(index int) int {
+func (v *Vector[T]) remove(index int) T {
 	r := v.items[index]
 	v.items = append(v.items[:index], v.items[index+1:]...)
 	return r
 }
 
-func (v *Vector) Remove(index int) (r int, err error) {
+func (v *Vector[T]) Remove(index int) (r T, err error) {
 	v.withLock(func() {
 		err = v.checkIndex(index)
 		if err != nil {
@@ -151,7 +164,7 @@ func (v *Vector) Remove(index int) (r int, err error) {
 	return
 }
 
-func (v *Vector) Peek(index int) (r int, err error) {
+func (v *Vector[T]) Peek(index int) (r T, err error) {
 	v.withRLock(func() {
 		err = v.checkIndex(index)
 		if err != nil {
@@ -164,11 +177,13 @@ func (v *Vector) Peek(index int) (r int, err error) {
 	return
 }
 
-func (v *Vector) IndexOf(item int) (r int) {
+// IndexOfFunc returns the index of the first item for which cb returns true,
+// or -1 if none match. Use the package-level IndexOf for comparable T.
+func (v *Vector[T]) IndexOfFunc(cb func(item T) bool) (r int) {
 	r = -1
 	v.withRLock(func() {
 		for i, candidate := range v.items {
-			if candidate == item {
+			if cb(candidate) {
 				r = i
 				break
 			}
@@ -177,44 +192,45 @@ func (v *Vector) IndexOf(item int) (r int) {
 	return
 }
 
-func (v *Vector) len() int {
+func (v *Vector[T]) len() int {
 	return len(v.items)
 }
 
-func (v *Vector) Len() (r int) {
+func (v *Vector[T]) Len() (r int) {
 	v.withRLock(func() {
 		r = v.len()
 	})
 	return
 }
 
-func (v *Vector) Cap() (r int) {
+func (v *Vector[T]) Cap() (r int) {
 	v.withRLock(func() {
 		r = cap(v.items)
 	})
 	return
 }
 
-func (v *Vector) each(cb func(index int, item int) bool) {
+func (v *Vector[T]) each(cb func(index int, item T) bool) {
 	for index, item := range v.items {
 		if !cb(index, item) {
 			return
 		}
 	}
 }
-func (v *Vector) Each(cb func(index, item int) bool) {
+
+func (v *Vector[T]) Each(cb func(index int, item T) bool) {
 	v.withRLock(func() {
 		v.each(cb)
 	})
 }
 
-func (v *Vector) Clear() {
+func (v *Vector[T]) Clear() {
 	v.withLock(func() {
 		v.items = v.items[:0]
 	})
 }
 
-func (v *Vector) Slice() (r []int) {
+func (v *Vector[T]) Slice() (r []T) {
 	v.withRLock(func() {
 		for _, item := range v.items {
 			r = append(r, item)
@@ -223,32 +239,20 @@ func (v *Vector) Slice() (r []int) {
 	return
 }
 
-func (v *Vector) Clone() (r *Vector) {
+func (v *Vector[T]) Clone() (r *Vector[T]) {
 	v.withRLock(func() {
-		r := NewWithCap(cap(v.items), v.multiplier)
-		r.append(v.items...)
-	})
-	return
-}
-
-func (v *Vector) InnerProduct(other *Vector) (r int, err error) {
-	v.withRLock(func() {
-		if v.len() != other.len() {
-			err = ErrSizeDiffers
-			return
-		}
-
-		for i, val1 := range v.items {
-			r += val1 * other.items[i]
-		}
+		c := NewWithCap[T](cap(v.items), v.multiplier)
+		c.encode = v.encode
+		c.decode = v.decode
+		c.append(v.items...)
+		r = c
 	})
-
 	return
 }
 
-func (v *Vector) Any(cb func(item int) bool) (r bool) {
+func (v *Vector[T]) Any(cb func(item T) bool) (r bool) {
 	v.withRLock(func() {
-		v.each(func(index, item int) bool {
+		v.each(func(index int, item T) bool {
 			if cb(item) {
 				r = true
 				return false
@@ -259,9 +263,9 @@ func (v *Vector) Any(cb func(item int) bool) (r bool) {
 	return
 }
 
-func (v *Vector) All(cb func(item int) bool) (r bool) {
+func (v *Vector[T]) All(cb func(item T) bool) (r bool) {
 	v.withRLock(func() {
-		v.each(func(index, item int) bool {
+		v.each(func(index int, item T) bool {
 			if index == 0 {
 				r = true // initial
 			}
@@ -275,7 +279,7 @@ func (v *Vector) All(cb func(item int) bool) (r bool) {
 	return
 }
 
-func (v *Vector) RemoveIf(cb func(item int) bool) {
+func (v *Vector[T]) RemoveIf(cb func(item T) bool) {
 	v.withLock(func() {
 		for i := len(v.items) - 1; i >= 0; i-- {
 			item := v.items[i]
@@ -288,7 +292,10 @@ func (v *Vector) RemoveIf(cb func(item int) bool) {
 	})
 }
 
-func (v *Vector) Equal(other *Vector) (r bool) {
+// EqualFunc reports whether v and other hold the same number of items and eq
+// returns true for every pair at matching indices. Use the package-level
+// Equal for comparable T.
+func (v *Vector[T]) EqualFunc(other *Vector[T], eq func(a, b T) bool) (r bool) {
 	v.withRLock(func() {
 		if v.len() != other.len() {
 			return
@@ -296,7 +303,7 @@ func (v *Vector) Equal(other *Vector) (r bool) {
 
 		for i, this := range v.items {
 			that := other.items[i]
-			if that != this {
+			if !eq(this, that) {
 				return
 			}
 		}
@@ -305,8 +312,8 @@ func (v *Vector) Equal(other *Vector) (r bool) {
 	return
 }
 
-func (v *Vector) Accumulate(cb func(a, b int) int) *Vector {
-	r := New()
+func (v *Vector[T]) Accumulate(cb func(a, b T) T) *Vector[T] {
+	r := New[T]()
 
 	v.withRLock(func() {
 		if v.len() == 0 {
@@ -323,7 +330,7 @@ func (v *Vector) Accumulate(cb func(a, b int) int) *Vector {
 	return r
 }
 
-func (v *Vector) Reduce(cb func(a, b int) int) (r int) {
+func (v *Vector[T]) Reduce(cb func(a, b T) T) (r T) {
 	v.withRLock(func() {
 		if v.len() == 0 {
 			return
@@ -337,3 +344,20 @@ func (v *Vector) Reduce(cb func(a, b int) int) (r int) {
 	})
 	return
 }
+
+// IndexOf returns the index of the first occurrence of item, or -1 if it is
+// not present. It requires T to be comparable; use (*Vector[T]).IndexOfFunc
+// otherwise.
+func IndexOf[T comparable](v *Vector[T], item T) int {
+	return v.IndexOfFunc(func(candidate T) bool {
+		return candidate == item
+	})
+}
+
+// Equal reports whether a and b hold the same items in the same order. It
+// requires T to be comparable; use (*Vector[T]).EqualFunc otherwise.
+func Equal[T comparable](a, b *Vector[T]) bool {
+	return a.EqualFunc(b, func(x, y T) bool {
+		return x == y
+	})
+}
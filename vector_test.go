@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/ykhrustalev/go-vector"
+	"strings"
 	"testing"
 )
 
@@ -12,7 +13,7 @@ func requireError(t *testing.T, actualErr, expectedError error) {
 	require.Equal(t, expectedError, actualErr)
 }
 
-func requireVector(t *testing.T, v *vector.Vector, expectedCap int, expectedItems []int) {
+func requireVector[T any](t *testing.T, v *vector.Vector[T], expectedCap int, expectedItems []T) {
 	require.Equal(t, expectedCap, v.Cap())
 	require.Equal(t, expectedItems, v.Slice())
 }
@@ -31,7 +32,7 @@ func TestFrom(t *testing.T) {
 }
 
 func TestNew(t *testing.T) {
-	v := vector.New()
+	v := vector.New[int]()
 	requireVector(t, v, 10, nil)
 
 	v.Append(1)
@@ -44,7 +45,7 @@ func TestNew(t *testing.T) {
 }
 
 func TestNewWithCap(t *testing.T) {
-	v := vector.NewWithCap(2, 3)
+	v := vector.NewWithCap[int](2, 3)
 	requireVector(t, v, 2, nil)
 
 	v.Append(1)
@@ -57,7 +58,7 @@ func TestNewWithCap(t *testing.T) {
 }
 
 func TestVector_Add(t *testing.T) {
-	v := vector.NewWithCap(2, 3)
+	v := vector.NewWithCap[int](2, 3)
 
 	requireError(t, v.Add(1, 11), vector.ErrInvalidIndex)
 
@@ -78,7 +79,7 @@ func TestVector_Add(t *testing.T) {
 }
 
 func TestVector_Set(t *testing.T) {
-	v := vector.NewWithCap(2, 3)
+	v := vector.NewWithCap[int](2, 3)
 
 	requireError(t, v.Set(0, 11), vector.ErrInvalidIndex)
 
@@ -92,7 +93,7 @@ func TestVector_Set(t *testing.T) {
 }
 
 func TestVector_Append(t *testing.T) {
-	v := vector.NewWithCap(2, 3)
+	v := vector.NewWithCap[int](2, 3)
 
 	v.Append(10)
 	v.Append(11)
@@ -105,7 +106,7 @@ func TestVector_Append(t *testing.T) {
 }
 
 func TestVector_AppendAll(t *testing.T) {
-	v := vector.NewWithCap(2, 3)
+	v := vector.NewWithCap[int](2, 3)
 
 	v.AppendAll(10, 11)
 	require.Equal(t, []int{10, 11}, v.Slice())
@@ -122,17 +123,17 @@ func TestVector_AppendAll(t *testing.T) {
 
 func TestVector_Cap(t *testing.T) {
 	t.Run("defaults", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		require.Equal(t, 10, v.Cap())
 	})
 
 	t.Run("with cap1", func(t *testing.T) {
-		v := vector.NewWithCap(2, 3)
+		v := vector.NewWithCap[int](2, 3)
 		require.Equal(t, 2, v.Cap())
 	})
 
 	t.Run("with cap2", func(t *testing.T) {
-		v := vector.NewWithCap(99, 3)
+		v := vector.NewWithCap[int](99, 3)
 		require.Equal(t, 99, v.Cap())
 	})
 }
@@ -154,13 +155,13 @@ func TestVector_Len(t *testing.T) {
 }
 
 func TestVector_Peek(t *testing.T) {
-	requireSuccess := func(t *testing.T, v *vector.Vector, index int, expectedItem int) {
+	requireSuccess := func(t *testing.T, v *vector.Vector[int], index int, expectedItem int) {
 		item, err := v.Peek(index)
 		require.NoError(t, err)
 		require.Equal(t, expectedItem, item)
 	}
 
-	requireError := func(t *testing.T, v *vector.Vector, index int) {
+	requireError := func(t *testing.T, v *vector.Vector[int], index int) {
 		_, err := v.Peek(index)
 		requireError(t, err, vector.ErrInvalidIndex)
 	}
@@ -177,29 +178,41 @@ func TestVector_Peek(t *testing.T) {
 	})
 
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		requireError(t, v, 0)
 	})
 }
 
-func TestVector_IndexOf(t *testing.T) {
+func TestIndexOf(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
-		require.Equal(t, -1, v.IndexOf(1))
+		v := vector.New[int]()
+		require.Equal(t, -1, vector.IndexOf(v, 1))
 	})
 
 	t.Run("with items", func(t *testing.T) {
 		v := vector.From(1, 2, 3, 4, 5, 1, 2, 3, 4, 5)
-		require.Equal(t, -1, v.IndexOf(0))
-		require.Equal(t, 0, v.IndexOf(1))
-		require.Equal(t, 2, v.IndexOf(3))
-		require.Equal(t, -1, v.IndexOf(6))
+		require.Equal(t, -1, vector.IndexOf(v, 0))
+		require.Equal(t, 0, vector.IndexOf(v, 1))
+		require.Equal(t, 2, vector.IndexOf(v, 3))
+		require.Equal(t, -1, vector.IndexOf(v, 6))
+	})
+}
+
+func TestVector_IndexOfFunc(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		v := vector.New[int]()
+		require.Equal(t, -1, v.IndexOfFunc(func(item int) bool { return item == 1 }))
+	})
+
+	t.Run("with items", func(t *testing.T) {
+		v := vector.From(1, 2, 3, 4, 5)
+		require.Equal(t, 2, v.IndexOfFunc(func(item int) bool { return item > 2 }))
 	})
 }
 
 func TestVector_Slice(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		require.Equal(t, []int(nil), v.Slice())
 	})
 
@@ -211,7 +224,7 @@ func TestVector_Slice(t *testing.T) {
 
 func TestVector_Clone(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		clone := v.Clone()
 		requireVector(t, clone, 10, []int(nil))
 		v.Append(1)
@@ -230,7 +243,7 @@ func TestVector_Clone(t *testing.T) {
 func TestVector_Remove(t *testing.T) {
 	requireSuccess := func(
 		t *testing.T,
-		v *vector.Vector,
+		v *vector.Vector[int],
 		index int,
 		expectedItem int,
 		expectedCap int,
@@ -242,13 +255,13 @@ func TestVector_Remove(t *testing.T) {
 		require.Equal(t, expectedItem, item)
 	}
 
-	requireError := func(t *testing.T, v *vector.Vector, index int) {
+	requireError := func(t *testing.T, v *vector.Vector[int], index int) {
 		_, err := v.Remove(index)
 		requireError(t, err, vector.ErrInvalidIndex)
 	}
 
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		requireError(t, v, 0)
 		requireError(t, v, 1)
 		requireError(t, v, -1)
@@ -268,7 +281,7 @@ func TestVector_Remove(t *testing.T) {
 }
 
 func TestVector_Each(t *testing.T) {
-	requireSuccess := func(t *testing.T, v *vector.Vector, expectedItems []int) {
+	requireSuccess := func(t *testing.T, v *vector.Vector[int], expectedItems []int) {
 		var actual []int
 		v.Each(func(index, item int) bool {
 			actual = append(actual, item)
@@ -278,7 +291,7 @@ func TestVector_Each(t *testing.T) {
 	}
 
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		requireSuccess(t, v, []int(nil))
 	})
 
@@ -302,37 +315,9 @@ func TestVector_Each(t *testing.T) {
 	})
 }
 
-func TestVector_InnerProduct(t *testing.T) {
-	t.Run("empty", func(t *testing.T) {
-		v1 := vector.New()
-		v2 := vector.New()
-
-		actual, err := v1.InnerProduct(v2)
-		require.NoError(t, err)
-		require.Equal(t, 0, actual)
-	})
-
-	t.Run("with items", func(t *testing.T) {
-		v1 := vector.From(1, 2, 3)
-		v2 := vector.From(2, 3, 4)
-
-		actual, err := v1.InnerProduct(v2)
-		require.NoError(t, err)
-		require.Equal(t, 1*2+2*3+3*4, actual)
-	})
-
-	t.Run("different size", func(t *testing.T) {
-		v1 := vector.New()
-		v2 := vector.From(2, 3, 4)
-
-		_, err := v1.InnerProduct(v2)
-		requireError(t, err, vector.ErrSizeDiffers)
-	})
-}
-
 func TestVector_Any(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 
 		require.False(t, v.Any(func(item int) bool { return true }))
 	})
@@ -348,7 +333,7 @@ func TestVector_Any(t *testing.T) {
 
 func TestVector_All(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 
 		require.False(t, v.All(func(item int) bool { return true }))
 	})
@@ -364,7 +349,7 @@ func TestVector_All(t *testing.T) {
 
 func TestVector_RemoveIf(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 
 		v.RemoveIf(func(item int) bool { return true })
 		requireVector(t, v, 10, []int(nil))
@@ -384,40 +369,58 @@ func TestVector_RemoveIf(t *testing.T) {
 	})
 }
 
-func TestVector_Equal(t *testing.T) {
+func TestEqual(t *testing.T) {
 	t.Run("equal empty", func(t *testing.T) {
-		v1 := vector.New()
-		v2 := vector.New()
+		v1 := vector.New[int]()
+		v2 := vector.New[int]()
 
-		require.True(t, v1.Equal(v2))
+		require.True(t, vector.Equal(v1, v2))
 	})
 
 	t.Run("equal with items", func(t *testing.T) {
 		v1 := vector.From(1, 2, 3)
 		v2 := vector.From(1, 2, 3)
 
-		require.True(t, v1.Equal(v2))
+		require.True(t, vector.Equal(v1, v2))
 	})
 
 	t.Run("not equal one empty", func(t *testing.T) {
-		v1 := vector.New()
+		v1 := vector.New[int]()
 		v2 := vector.From(1, 2, 3)
 
-		require.False(t, v1.Equal(v2))
+		require.False(t, vector.Equal(v1, v2))
 	})
 
 	t.Run("not equal diff len", func(t *testing.T) {
 		v1 := vector.From(1, 2)
 		v2 := vector.From(1, 2, 3)
 
-		require.False(t, v1.Equal(v2))
+		require.False(t, vector.Equal(v1, v2))
 	})
 
 	t.Run("not equal same len", func(t *testing.T) {
 		v1 := vector.From(1, 2, 4)
 		v2 := vector.From(1, 2, 3)
 
-		require.False(t, v1.Equal(v2))
+		require.False(t, vector.Equal(v1, v2))
+	})
+}
+
+func TestVector_EqualFunc(t *testing.T) {
+	ignoreCase := strings.EqualFold
+
+	t.Run("equal ignoring case", func(t *testing.T) {
+		v1 := vector.From("a", "B", "c")
+		v2 := vector.From("A", "b", "C")
+
+		require.True(t, v1.EqualFunc(v2, ignoreCase))
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		v1 := vector.From("a", "b")
+		v2 := vector.From("a", "c")
+
+		require.False(t, v1.EqualFunc(v2, ignoreCase))
 	})
 }
 
@@ -425,8 +428,8 @@ func TestVector_Accumulate(t *testing.T) {
 	sum := func(a, b int) int { return a + b }
 
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
-		assert.Equal(t, vector.New().Slice(), v.Accumulate(sum).Slice())
+		v := vector.New[int]()
+		assert.Equal(t, vector.New[int]().Slice(), v.Accumulate(sum).Slice())
 	})
 
 	t.Run("single items", func(t *testing.T) {
@@ -444,7 +447,7 @@ func TestVector_Reduce(t *testing.T) {
 	sum := func(a, b int) int { return a + b }
 
 	t.Run("empty", func(t *testing.T) {
-		v := vector.New()
+		v := vector.New[int]()
 		assert.Equal(t, 0, v.Reduce(sum))
 	})
 
@@ -458,3 +461,65 @@ func TestVector_Reduce(t *testing.T) {
 		assert.Equal(t, 15, v.Reduce(sum))
 	})
 }
+
+// person is a small comparable struct used to exercise Vector with a custom
+// element type.
+type person struct {
+	name string
+	age  int
+}
+
+func TestVector_Generic(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := vector.From("a", "b", "c")
+		requireVector(t, v, 10, []string{"a", "b", "c"})
+
+		v.Append("d")
+		requireVector(t, v, 10, []string{"a", "b", "c", "d"})
+
+		require.Equal(t, 1, vector.IndexOf(v, "b"))
+
+		item, err := v.Remove(0)
+		require.NoError(t, err)
+		require.Equal(t, "a", item)
+		requireVector(t, v, 10, []string{"b", "c", "d"})
+
+		clone := v.Clone()
+		require.True(t, vector.Equal(v, clone))
+
+		require.True(t, v.Any(func(item string) bool { return item == "c" }))
+		require.False(t, v.All(func(item string) bool { return item == "c" }))
+
+		joined := v.Reduce(func(a, b string) string { return a + b })
+		require.Equal(t, "bcd", joined)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		alice := person{name: "alice", age: 30}
+		bob := person{name: "bob", age: 25}
+		carol := person{name: "carol", age: 40}
+
+		v := vector.From(alice, bob, carol)
+		requireVector(t, v, 10, []person{alice, bob, carol})
+
+		require.Equal(t, 1, vector.IndexOf(v, bob))
+
+		item, err := v.Peek(2)
+		require.NoError(t, err)
+		require.Equal(t, carol, item)
+
+		clone := v.Clone()
+		require.True(t, vector.Equal(v, clone))
+
+		v.RemoveIf(func(item person) bool { return item.age < 30 })
+		requireVector(t, v, 10, []person{alice, carol})
+
+		oldest := v.Reduce(func(a, b person) person {
+			if b.age > a.age {
+				return b
+			}
+			return a
+		})
+		require.Equal(t, carol, oldest)
+	})
+}